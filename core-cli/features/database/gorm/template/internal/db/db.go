@@ -0,0 +1,27 @@
+// Package db opens the GORM connection for whichever driver the project is
+// configured with, so the Postgres/MySQL/SQLite starters share one flow.
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// Open opens a GORM connection for driver ("postgres", "mysql", "sqlite")
+// using dsn, which is read from DATABASE_URL by callers.
+func Open(driver, dsn string) (*gorm.DB, error) {
+	switch driver {
+	case "postgres":
+		return gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	case "mysql":
+		return gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	case "sqlite":
+		return gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	default:
+		return nil, fmt.Errorf("unsupported DB_DRIVER %q", driver)
+	}
+}
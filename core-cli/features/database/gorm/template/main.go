@@ -0,0 +1,24 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"gorm-starter/internal/db"
+	"gorm-starter/models"
+)
+
+func main() {
+	godotenv.Load()
+
+	conn, err := db.Open(os.Getenv("DB_DRIVER"), os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := conn.AutoMigrate(models.All()...); err != nil {
+		log.Fatal(err)
+	}
+}
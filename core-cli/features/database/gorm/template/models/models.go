@@ -0,0 +1,8 @@
+// Package models holds the project's GORM models. `pi generate model` adds
+// a struct here and adds it to All() so main.go's AutoMigrate picks it up.
+package models
+
+// All returns every model registered for AutoMigrate.
+func All() []interface{} {
+	return []interface{}{}
+}
@@ -0,0 +1,8 @@
+package models
+
+// User is the account record created by the --auth scaffold's signup handler.
+type User struct {
+	ID           uint   `gorm:"primaryKey"`
+	Email        string `gorm:"uniqueIndex"`
+	PasswordHash string
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// NewSessionCookie signs userID with key (SESSION_KEY from .env) and returns
+// the cookie value "<userID>.<signature>".
+func NewSessionCookie(userID string, key []byte) string {
+	return userID + "." + sign(userID, key)
+}
+
+// ParseSessionCookie verifies value against key and returns the user ID it
+// was issued for. It splits on the last "." rather than the first, since
+// userID is an email address that may itself contain dots, while sig
+// (base64 RawURLEncoding) never does.
+func ParseSessionCookie(value string, key []byte) (string, error) {
+	idx := strings.LastIndex(value, ".")
+	if idx < 0 {
+		return "", fmt.Errorf("malformed session cookie")
+	}
+	userID, sig := value[:idx], value[idx+1:]
+	if !hmac.Equal([]byte(sig), []byte(sign(userID, key))) {
+		return "", fmt.Errorf("invalid session signature")
+	}
+	return userID, nil
+}
+
+func sign(userID string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(userID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
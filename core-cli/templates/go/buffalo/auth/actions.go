@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gobuffalo/buffalo"
+
+	"buffalo-starter/models"
+	authpkg "buffalo-starter/pkg/auth"
+)
+
+// Store is the subset of persistence signup/login need.
+type Store interface {
+	CreateUser(u *models.User) error
+	UserByEmail(email string) (*models.User, error)
+}
+
+// Register mounts signup/login/logout onto app. actions.App calls this
+// alongside its "/" route when the project was scaffolded with --auth.
+func Register(app *buffalo.App, db Store, sessionKey []byte) {
+	app.POST("/signup", signupHandler(db))
+	app.POST("/login", loginHandler(db, sessionKey))
+	app.POST("/logout", logoutHandler)
+	app.GET("/me", meHandler(sessionKey))
+}
+
+func signupHandler(db Store) buffalo.Handler {
+	return func(c buffalo.Context) error {
+		var req struct{ Email, Password string }
+		if err := c.Bind(&req); err != nil {
+			return c.Error(http.StatusBadRequest, err)
+		}
+
+		hash, err := authpkg.HashPassword(req.Password)
+		if err != nil {
+			return c.Error(http.StatusInternalServerError, err)
+		}
+
+		user := &models.User{Email: req.Email, PasswordHash: hash}
+		if err := db.CreateUser(user); err != nil {
+			return c.Error(http.StatusConflict, err)
+		}
+		return c.Render(http.StatusCreated, nil)
+	}
+}
+
+func loginHandler(db Store, sessionKey []byte) buffalo.Handler {
+	return func(c buffalo.Context) error {
+		var req struct{ Email, Password string }
+		if err := c.Bind(&req); err != nil {
+			return c.Error(http.StatusBadRequest, err)
+		}
+
+		user, err := db.UserByEmail(req.Email)
+		if err != nil {
+			return c.Error(http.StatusUnauthorized, err)
+		}
+
+		ok, err := authpkg.VerifyPassword(req.Password, user.PasswordHash)
+		if err != nil || !ok {
+			return c.Error(http.StatusUnauthorized, err)
+		}
+
+		c.Cookies().Set("session", authpkg.NewSessionCookie(user.Email, sessionKey), 24*time.Hour)
+		return c.Render(http.StatusOK, nil)
+	}
+}
+
+func logoutHandler(c buffalo.Context) error {
+	c.Cookies().Set("session", "", -1*time.Second)
+	return c.Render(http.StatusOK, nil)
+}
+
+// meHandler is the one protected route that actually verifies the session
+// cookie login sets, via authpkg.ParseSessionCookie.
+func meHandler(sessionKey []byte) buffalo.Handler {
+	return func(c buffalo.Context) error {
+		cookie, err := c.Cookies().Get("session")
+		if err != nil {
+			return c.Error(http.StatusUnauthorized, err)
+		}
+
+		if _, err := authpkg.ParseSessionCookie(cookie, sessionKey); err != nil {
+			return c.Error(http.StatusUnauthorized, err)
+		}
+		return c.Render(http.StatusOK, nil)
+	}
+}
@@ -1,9 +1,20 @@
 package actions
 
-import "github.com/gobuffalo/buffalo"
+import (
+	"os"
+
+	"github.com/gobuffalo/buffalo"
+
+	"buffalo-starter/telemetry"
+)
 
 func App() *buffalo.App {
 	app := buffalo.New(buffalo.Options{})
+
+	logger := telemetry.NewLogger(os.Getenv("APP_ENV"))
+	logger = telemetry.MaybeExportOTLP(logger)
+	app.Use(telemetry.Middleware(logger))
+
 	app.GET("/", func(c buffalo.Context) error {
 		return c.Render(200, r.String("Hello Buffalo"))
 	})
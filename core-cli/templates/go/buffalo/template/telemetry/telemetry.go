@@ -0,0 +1,93 @@
+// Package telemetry gives this service structured request logging via
+// log/slog, with optional OTLP export.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gobuffalo/buffalo"
+)
+
+// NewLogger returns a JSON slog.Logger tagged with env ("development",
+// "production", ...).
+func NewLogger(env string) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(os.Stdout, nil)).With("env", env)
+}
+
+// MaybeExportOTLP wraps logger so every record is also POSTed as a minimal
+// OTLP/HTTP logs payload to OTEL_EXPORTER_OTLP_ENDPOINT. It returns logger
+// unchanged when that env var is unset.
+func MaybeExportOTLP(logger *slog.Logger) *slog.Logger {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if endpoint == "" {
+		return logger
+	}
+	return slog.New(otlpHandler{
+		Handler: logger.Handler(),
+		url:     strings.TrimRight(endpoint, "/") + "/v1/logs",
+		client:  &http.Client{Timeout: 2 * time.Second},
+	})
+}
+
+// otlpHandler forwards every record it handles to both the wrapped Handler
+// and an OTLP/HTTP logs endpoint. Export failures are swallowed so a flaky
+// collector never breaks application logging.
+type otlpHandler struct {
+	slog.Handler
+	url    string
+	client *http.Client
+}
+
+func (h otlpHandler) Handle(ctx context.Context, rec slog.Record) error {
+	if err := h.Handler.Handle(ctx, rec); err != nil {
+		return err
+	}
+
+	attrs := map[string]any{}
+	rec.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value.Any()
+		return true
+	})
+	body, err := json.Marshal(map[string]any{
+		"resourceLogs": []map[string]any{{
+			"scopeLogs": []map[string]any{{
+				"logRecords": []map[string]any{{
+					"body":       map[string]any{"stringValue": rec.Message},
+					"attributes": attrs,
+				}},
+			}},
+		}},
+	})
+	if err != nil {
+		return nil
+	}
+	resp, err := h.client.Post(h.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Middleware logs each request's method, path, and duration.
+func Middleware(logger *slog.Logger) buffalo.MiddlewareFunc {
+	return func(next buffalo.Handler) buffalo.Handler {
+		return func(c buffalo.Context) error {
+			start := time.Now()
+			err := next(c)
+			logger.Info("request",
+				"method", c.Request().Method,
+				"path", c.Request().URL.Path,
+				"duration_ms", time.Since(start).Milliseconds(),
+			)
+			return err
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"chi-starter/models"
+	authpkg "chi-starter/pkg/auth"
+)
+
+// Store is the subset of persistence signup/login need.
+type Store interface {
+	CreateUser(u *models.User) error
+	UserByEmail(email string) (*models.User, error)
+}
+
+// Register mounts signup/login/logout/me onto r. main.go calls this alongside
+// routes.Router() when the project was scaffolded with --auth.
+func Register(r chi.Router, db Store, sessionKey []byte) {
+	r.Post("/signup", signupHandler(db))
+	r.Post("/login", loginHandler(db, sessionKey))
+	r.Post("/logout", logoutHandler)
+	r.Get("/me", meHandler(sessionKey))
+}
+
+func signupHandler(db Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Email, Password string }
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		hash, err := authpkg.HashPassword(req.Password)
+		if err != nil {
+			http.Error(w, "could not hash password", http.StatusInternalServerError)
+			return
+		}
+
+		user := &models.User{Email: req.Email, PasswordHash: hash}
+		if err := db.CreateUser(user); err != nil {
+			http.Error(w, "could not create user", http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func loginHandler(db Store, sessionKey []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Email, Password string }
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		user, err := db.UserByEmail(req.Email)
+		if err != nil {
+			http.Error(w, "invalid credentials", http.StatusUnauthorized)
+			return
+		}
+
+		ok, err := authpkg.VerifyPassword(req.Password, user.PasswordHash)
+		if err != nil || !ok {
+			http.Error(w, "invalid credentials", http.StatusUnauthorized)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: authpkg.NewSessionCookie(user.Email, sessionKey), HttpOnly: true, Path: "/"})
+	}
+}
+
+func logoutHandler(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{Name: "session", Value: "", MaxAge: -1, Path: "/"})
+}
+
+// meHandler is the one protected route that actually verifies the session
+// cookie login sets, via authpkg.ParseSessionCookie.
+func meHandler(sessionKey []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil {
+			http.Error(w, "not authenticated", http.StatusUnauthorized)
+			return
+		}
+
+		userID, err := authpkg.ParseSessionCookie(cookie.Value, sessionKey)
+		if err != nil {
+			http.Error(w, "not authenticated", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"email": userID})
+	}
+}
@@ -2,9 +2,15 @@ package main
 
 import (
 	"net/http"
+	"os"
+
 	"chi-starter/routes"
+	"chi-starter/telemetry"
 )
 
 func main() {
-	http.ListenAndServe(":3000", routes.Router())
+	logger := telemetry.NewLogger(os.Getenv("APP_ENV"))
+	logger = telemetry.MaybeExportOTLP(logger)
+
+	http.ListenAndServe(":3000", telemetry.Middleware(logger)(routes.Router()))
 }
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"fiber-starter/models"
+	authpkg "fiber-starter/pkg/auth"
+)
+
+// Store is the subset of persistence signup/login need.
+type Store interface {
+	CreateUser(u *models.User) error
+	UserByEmail(email string) (*models.User, error)
+}
+
+// Register wires signup/login/logout/me onto app. main.go calls this after
+// routes.Register when the project was scaffolded with --auth.
+func Register(app *fiber.App, db Store, sessionKey []byte) {
+	app.Post("/signup", signupHandler(db))
+	app.Post("/login", loginHandler(db, sessionKey))
+	app.Post("/logout", logoutHandler())
+	app.Get("/me", meHandler(sessionKey))
+}
+
+func signupHandler(db Store) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req struct{ Email, Password string }
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		hash, err := authpkg.HashPassword(req.Password)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "could not hash password"})
+		}
+
+		user := &models.User{Email: req.Email, PasswordHash: hash}
+		if err := db.CreateUser(user); err != nil {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "could not create user"})
+		}
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"id": user.ID})
+	}
+}
+
+func loginHandler(db Store, sessionKey []byte) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req struct{ Email, Password string }
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		user, err := db.UserByEmail(req.Email)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid credentials"})
+		}
+
+		ok, err := authpkg.VerifyPassword(req.Password, user.PasswordHash)
+		if err != nil || !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid credentials"})
+		}
+
+		c.Cookie(&fiber.Cookie{Name: "session", Value: authpkg.NewSessionCookie(user.Email, sessionKey), HTTPOnly: true})
+		return c.JSON(fiber.Map{"ok": true})
+	}
+}
+
+func logoutHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.ClearCookie("session")
+		return c.JSON(fiber.Map{"ok": true})
+	}
+}
+
+// meHandler is the one protected route that actually verifies the session
+// cookie login sets, via authpkg.ParseSessionCookie.
+func meHandler(sessionKey []byte) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cookie := c.Cookies("session")
+		if cookie == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "not authenticated"})
+		}
+
+		userID, err := authpkg.ParseSessionCookie(cookie, sessionKey)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "not authenticated"})
+		}
+		return c.JSON(fiber.Map{"email": userID})
+	}
+}
@@ -1,13 +1,20 @@
 package main
 
 import (
+	"os"
+
 	"fiber-starter/routes"
+	"fiber-starter/telemetry"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 func main() {
+	logger := telemetry.NewLogger(os.Getenv("APP_ENV"))
+	logger = telemetry.MaybeExportOTLP(logger)
+
 	app := fiber.New()
+	app.Use(telemetry.Middleware(logger))
 	routes.Register(app)
 	app.Listen(":3000")
 }
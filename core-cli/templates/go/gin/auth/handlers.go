@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-starter/models"
+	authpkg "gin-starter/pkg/auth"
+)
+
+// Register wires signup/login/logout/me onto r. main.go calls this after
+// routes.Register when the project was scaffolded with --auth.
+func Register(r *gin.Engine, db Store, sessionKey []byte) {
+	r.POST("/signup", signupHandler(db))
+	r.POST("/login", loginHandler(db, sessionKey))
+	r.POST("/logout", logoutHandler())
+	r.GET("/me", meHandler(sessionKey))
+}
+
+// Store is the subset of persistence signup/login need; main.go passes
+// NewMemoryStore().
+type Store interface {
+	CreateUser(u *models.User) error
+	UserByEmail(email string) (*models.User, error)
+}
+
+func signupHandler(db Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct{ Email, Password string }
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		hash, err := authpkg.HashPassword(req.Password)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "could not hash password"})
+			return
+		}
+
+		user := &models.User{Email: req.Email, PasswordHash: hash}
+		if err := db.CreateUser(user); err != nil {
+			c.JSON(http.StatusConflict, gin.H{"error": "could not create user"})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"id": user.ID})
+	}
+}
+
+func loginHandler(db Store, sessionKey []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct{ Email, Password string }
+		if err := c.BindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		user, err := db.UserByEmail(req.Email)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+			return
+		}
+
+		ok, err := authpkg.VerifyPassword(req.Password, user.PasswordHash)
+		if err != nil || !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+			return
+		}
+
+		c.SetCookie("session", authpkg.NewSessionCookie(user.Email, sessionKey), 3600*24, "/", "", false, true)
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	}
+}
+
+func logoutHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.SetCookie("session", "", -1, "/", "", false, true)
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	}
+}
+
+// meHandler is the one protected route that actually verifies the session
+// cookie login sets, via authpkg.ParseSessionCookie.
+func meHandler(sessionKey []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cookie, err := c.Cookie("session")
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+			return
+		}
+
+		userID, err := authpkg.ParseSessionCookie(cookie, sessionKey)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"email": userID})
+	}
+}
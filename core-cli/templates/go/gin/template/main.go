@@ -1,13 +1,20 @@
 package main
 
 import (
+	"os"
+
 	"gin-starter/routes"
+	"gin-starter/telemetry"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
+	logger := telemetry.NewLogger(os.Getenv("APP_ENV"))
+	logger = telemetry.MaybeExportOTLP(logger)
+
 	r := gin.Default()
+	r.Use(telemetry.Middleware(logger))
 	routes.Register(r)
 	r.Run(":3000")
 }
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"fmt"
+	"sync"
+
+	"gorilla-starter/models"
+)
+
+// memoryStore is the default Store --auth wires up: enough to signup/login
+// against without requiring a database. Swap in a GORM-backed Store once
+// the project has persistence.
+type memoryStore struct {
+	mu     sync.Mutex
+	byID   map[uint]*models.User
+	nextID uint
+}
+
+// NewMemoryStore returns a Store backed by an in-process map.
+func NewMemoryStore() Store {
+	return &memoryStore{byID: map[uint]*models.User{}, nextID: 1}
+}
+
+func (s *memoryStore) CreateUser(u *models.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.byID {
+		if existing.Email == u.Email {
+			return fmt.Errorf("email already registered")
+		}
+	}
+	u.ID = s.nextID
+	s.nextID++
+	s.byID[u.ID] = u
+	return nil
+}
+
+func (s *memoryStore) UserByEmail(email string) (*models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.byID {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, fmt.Errorf("user not found")
+}
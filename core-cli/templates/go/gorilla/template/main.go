@@ -1,15 +1,22 @@
 package main
 
 import (
-	"gorilla-starter/handlers"
 	"log"
 	"net/http"
+	"os"
+
+	"gorilla-starter/handlers"
+	"gorilla-starter/telemetry"
 
 	"github.com/gorilla/mux"
 )
 
 func main() {
+	logger := telemetry.NewLogger(os.Getenv("APP_ENV"))
+	logger = telemetry.MaybeExportOTLP(logger)
+
 	r := mux.NewRouter()
+	r.Use(telemetry.Middleware(logger))
 	r.HandleFunc("/", handlers.Home).Methods("GET")
 
 	log.Println("Server running on :3000")
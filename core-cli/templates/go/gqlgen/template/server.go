@@ -3,17 +3,24 @@ package main
 import (
 	"log"
 	"net/http"
+	"os"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
+
+	"gqlgen-starter/telemetry"
 )
 
 func main() {
+	logger := telemetry.NewLogger(os.Getenv("APP_ENV"))
+	logger = telemetry.MaybeExportOTLP(logger)
+
 	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: &Resolver{}}))
 
-	http.Handle("/", playground.Handler("GraphQL", "/query"))
-	http.Handle("/query", srv)
+	mux := http.NewServeMux()
+	mux.Handle("/", playground.Handler("GraphQL", "/query"))
+	mux.Handle("/query", srv)
 
 	log.Println("Running on :3000")
-	log.Fatal(http.ListenAndServe(":3000", nil))
+	log.Fatal(http.ListenAndServe(":3000", telemetry.Middleware(logger)(mux)))
 }
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/BurntSushi/toml"
+	"github.com/gorilla/mux"
+)
+
+// Package maps an import path (without the domain) to the VCS repo that
+// backs it, e.g. "foo" -> "https://git.example.com/user/foo".
+type Package struct {
+	Repo string `toml:"repo"`
+}
+
+type Config struct {
+	Domain   string             `toml:"domain"`
+	VCS      string             `toml:"vcs"`
+	Packages map[string]Package `toml:"packages"`
+}
+
+var metaTmpl = template.Must(template.New("meta").Parse(`<!DOCTYPE html>
+<html><head>
+<meta name="go-import" content="{{.ImportPath}} {{.VCS}} {{.Repo}}">
+</head></html>
+`))
+
+func main() {
+	var cfg Config
+	if _, err := toml.DecodeFile("packages.toml", &cfg); err != nil {
+		log.Fatalf("loading packages.toml: %v", err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/{pkg}", vanityHandler(cfg)).Methods("GET")
+
+	addr := ":" + port()
+	log.Printf("vanity redirector for %s running on %s", cfg.Domain, addr)
+	log.Fatal(http.ListenAndServe(addr, r))
+}
+
+func vanityHandler(cfg Config) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("go-get") != "1" {
+			http.NotFound(w, req)
+			return
+		}
+		name := mux.Vars(req)["pkg"]
+		pkg, ok := cfg.Packages[name]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		metaTmpl.Execute(w, struct {
+			ImportPath string
+			VCS        string
+			Repo       string
+		}{
+			ImportPath: fmt.Sprintf("%s/%s", cfg.Domain, name),
+			VCS:        cfg.VCS,
+			Repo:       pkg.Repo,
+		})
+	}
+}
+
+func port() string {
+	if p := os.Getenv("PORT"); p != "" {
+		return p
+	}
+	return "8080"
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"package-installer-cli/internal/doctor"
+	"package-installer-cli/internal/registry"
+
+	"github.com/spf13/cobra"
+)
+
+var doctorFix bool
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the local environment for issues",
+	RunE: func(c *cobra.Command, args []string) error {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		checks, err := doctor.Selected(cwd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		failed := false
+		for _, check := range checks {
+			result := check.Run(ctx)
+			fmt.Printf("%-6s %-16s %s\n", result.Status, check.Name(), result.Detail)
+
+			if result.Status == doctor.Fail {
+				failed = true
+			}
+			if result.Status != doctor.Pass && result.Remediation != "" {
+				fmt.Printf("       %s\n", result.Remediation)
+			}
+			if result.Status != doctor.Pass && doctorFix {
+				if fixable, ok := check.(doctor.Fixable); ok {
+					if err := fixable.Fix(ctx); err != nil {
+						fmt.Printf("       fix failed: %v\n", err)
+					} else {
+						fmt.Printf("       fixed\n")
+					}
+				}
+			}
+		}
+
+		if failed {
+			return fmt.Errorf("one or more required checks failed")
+		}
+		return nil
+	},
+}
+
+func init() {
+	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "attempt to auto-fix failing checks")
+	registry.MarkPorted("doctor")
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"package-installer-cli/internal/dbscaffold"
+	"package-installer-cli/internal/registry"
+
+	"github.com/spf13/cobra"
+)
+
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate code into the current project",
+}
+
+var generateModelCmd = &cobra.Command{
+	Use:   "model <Name> [field:type ...]",
+	Short: "Generate a GORM model, migration, and repository",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		fields, err := dbscaffold.ParseFields(args[1:])
+		if err != nil {
+			return err
+		}
+		spec := dbscaffold.ModelSpec{Name: dbscaffold.Capitalize(args[0]), Fields: fields}
+
+		if err := dbscaffold.GenerateModel(spec, "models"); err != nil {
+			return err
+		}
+		migration, err := dbscaffold.GenerateMigration(spec, "migrations")
+		if err != nil {
+			return err
+		}
+		if err := dbscaffold.GenerateRepository(spec, "repository"); err != nil {
+			return err
+		}
+
+		fileBase := strings.ToLower(spec.Name)
+		fmt.Printf("Generated models/%s.go, %s, repository/%s_repository.go\n", fileBase, migration, fileBase)
+		return nil
+	},
+}
+
+func init() {
+	generateCmd.AddCommand(generateModelCmd)
+	registry.MarkPorted("generate")
+	rootCmd.AddCommand(generateCmd)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"package-installer-cli/internal/depinstall"
+	"package-installer-cli/internal/legacy"
+	"package-installer-cli/internal/registry"
+
+	"github.com/spf13/cobra"
+)
+
+var installCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install dependencies for the current project",
+	RunE: func(c *cobra.Command, args []string) error {
+		if shouldFallback("install") {
+			return legacy.Run(append([]string{"install"}, args...))
+		}
+		return depinstall.Install(".")
+	},
+}
+
+func init() {
+	registry.MarkPorted("install")
+}
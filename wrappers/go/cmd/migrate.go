@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+
+	"package-installer-cli/internal/dbscaffold"
+	"package-installer-cli/internal/registry"
+
+	"github.com/spf13/cobra"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending SQL migrations from ./migrations against DATABASE_URL",
+	RunE: func(c *cobra.Command, args []string) error {
+		conn, driver, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		return dbscaffold.Migrate(conn, driver, "migrations")
+	},
+}
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Revert the highest-numbered applied migration",
+	RunE: func(c *cobra.Command, args []string) error {
+		conn, driver, err := openDB()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		return dbscaffold.Rollback(conn, driver, "migrations")
+	},
+}
+
+// openDB opens the database DB_DRIVER/DATABASE_URL point at and returns the
+// driver name alongside the connection, since dbscaffold needs it to pick
+// the right placeholder syntax.
+func openDB() (*sql.DB, string, error) {
+	driver := os.Getenv("DB_DRIVER")
+	dsn := os.Getenv("DATABASE_URL")
+	if driver == "" || dsn == "" {
+		return nil, "", fmt.Errorf("DB_DRIVER and DATABASE_URL must be set")
+	}
+
+	driverName := driver
+	if driver == "sqlite" {
+		driverName = "sqlite3"
+	}
+	conn, err := sql.Open(driverName, dsn)
+	return conn, driver, err
+}
+
+func init() {
+	registry.MarkPorted("migrate")
+	registry.MarkPorted("rollback")
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(rollbackCmd)
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"package-installer-cli/internal/authscaffold"
+	"package-installer-cli/internal/installer"
+	"package-installer-cli/internal/legacy"
+	"package-installer-cli/internal/registry"
+	"package-installer-cli/internal/telemetry"
+	"package-installer-cli/internal/templates"
+
+	"github.com/spf13/cobra"
+)
+
+var newAuth bool
+
+var newCmd = &cobra.Command{
+	Use:   "new <template> <name>",
+	Short: "Scaffold a new project from a starter template",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(c *cobra.Command, args []string) error {
+		if shouldFallback("new") {
+			return legacy.Run(append([]string{"new"}, args...))
+		}
+		t, err := templates.Find(args[0])
+		if err != nil {
+			return err
+		}
+		if err := installer.New(t, args[1]); err != nil {
+			return err
+		}
+		if newAuth {
+			if err := authscaffold.Apply(t.Name, templates.Root, args[1]); err != nil {
+				return err
+			}
+		}
+		telemetry.PingTemplateChosen(telemetry.NewLogger(), t.Name)
+		fmt.Printf("Scaffolded %s into %s\n", t.Name, args[1])
+		fmt.Printf("Run `cd %s && go mod tidy` to fetch dependencies.\n", args[1])
+		return nil
+	},
+}
+
+func init() {
+	newCmd.Flags().BoolVar(&newAuth, "auth", false, "scaffold a users/session subsystem with argon2id password hashing")
+	registry.MarkPorted("new")
+}
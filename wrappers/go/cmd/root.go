@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"package-installer-cli/internal/legacy"
+	"package-installer-cli/internal/registry"
+)
+
+const (
+	appName    = "Package Installer CLI"
+	appVersion = "2.0.0"
+)
+
+// legacyFlag sends install, new and update through the embedded Node bundle
+// instead of their native Go implementation; the other native subcommands
+// ignore it. Subcommands with no native implementation fall back to the
+// bundle automatically (see Execute).
+var legacyFlag bool
+
+var rootCmd = &cobra.Command{
+	Use:     "pi",
+	Short:   appName,
+	Version: appVersion,
+}
+
+// Execute runs the root command, dispatching to native subcommands or
+// falling back to the embedded Node bundle.
+func Execute() error {
+	rootCmd.InitDefaultHelpCmd()
+	rootCmd.InitDefaultCompletionCmd()
+	// A subcommand cobra doesn't know has no native implementation: hand it
+	// and its arguments to the bundle unchanged.
+	if args := os.Args[1:]; len(args) > 0 {
+		if _, _, err := rootCmd.Find(args); err != nil {
+			return legacy.Run(args)
+		}
+	}
+	return rootCmd.Execute()
+}
+
+// shouldFallback reports whether subcommand name should run through the
+// legacy Node bundle instead of its native implementation.
+func shouldFallback(name string) bool {
+	return legacyFlag || !registry.IsPorted(name)
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&legacyFlag, "legacy", false, "run the embedded Node bundle instead of the native implementation")
+
+	rootCmd.AddCommand(installCmd)
+	rootCmd.AddCommand(newCmd)
+	rootCmd.AddCommand(updateCmd)
+	rootCmd.AddCommand(doctorCmd)
+}
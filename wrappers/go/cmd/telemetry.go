@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"package-installer-cli/internal/registry"
+	"package-installer-cli/internal/telemetry"
+
+	"github.com/spf13/cobra"
+)
+
+var telemetryCmd = &cobra.Command{
+	Use:   "telemetry [on|off]",
+	Short: "Enable or disable anonymous usage pings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		switch args[0] {
+		case "on":
+			if err := telemetry.SetEnabled(true); err != nil {
+				return err
+			}
+			fmt.Println("Telemetry enabled")
+		case "off":
+			if err := telemetry.SetEnabled(false); err != nil {
+				return err
+			}
+			fmt.Println("Telemetry disabled")
+		default:
+			return fmt.Errorf("usage: pi telemetry [on|off]")
+		}
+		return nil
+	},
+}
+
+func init() {
+	registry.MarkPorted("telemetry")
+	rootCmd.AddCommand(telemetryCmd)
+}
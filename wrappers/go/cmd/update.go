@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"package-installer-cli/internal/depinstall"
+	"package-installer-cli/internal/legacy"
+	"package-installer-cli/internal/registry"
+
+	"github.com/spf13/cobra"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a scaffolded project's dependencies",
+	RunE: func(c *cobra.Command, args []string) error {
+		if shouldFallback("update") {
+			return legacy.Run(append([]string{"update"}, args...))
+		}
+		return depinstall.Update(".")
+	},
+}
+
+func init() {
+	registry.MarkPorted("update")
+}
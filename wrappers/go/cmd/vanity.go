@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"package-installer-cli/internal/registry"
+	"package-installer-cli/internal/vanity"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	vanityDomain   string
+	vanityVCS      string
+	vanityPackages string
+)
+
+var vanityCmd = &cobra.Command{
+	Use:   "vanity <name>",
+	Short: "Scaffold a self-hosted go-get vanity redirector service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		cfg := vanity.Config{
+			Domain:       vanityDomain,
+			VCS:          vanityVCS,
+			PackagesFile: vanityPackages,
+		}
+		if err := vanity.Generate(cfg, args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("Scaffolded vanity redirector into %s\n", args[0])
+		fmt.Printf("Run `cd %s && go mod tidy` to fetch dependencies.\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	vanityCmd.Flags().StringVar(&vanityDomain, "domain", "example.com", "domain the vanity service serves import paths for")
+	vanityCmd.Flags().StringVar(&vanityVCS, "vcs", "git", "VCS backing the packages (git, etc.)")
+	vanityCmd.Flags().StringVar(&vanityPackages, "packages", "", "existing packages.toml to seed the project with")
+
+	registry.MarkPorted("vanity")
+	rootCmd.AddCommand(vanityCmd)
+}
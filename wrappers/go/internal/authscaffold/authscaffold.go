@@ -0,0 +1,153 @@
+// Package authscaffold overlays the shared argon2id auth subsystem onto a
+// freshly scaffolded web-framework starter when `pi new` is run with --auth.
+package authscaffold
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const sharedAuthDir = "_shared/auth"
+
+// frameworkEntry describes how to wire the auth package into one
+// framework's entry point: which file to patch (relative to destDir) and
+// the patch itself.
+type frameworkEntry struct {
+	entryFile string
+	patch     func(src string) (string, bool)
+}
+
+// frameworkPatch rewrites a starter's entry point to wire the auth package
+// in alongside its existing router registration.
+var frameworkPatch = map[string]frameworkEntry{
+	"gin": {"main.go", patchSimple("gin-starter", `routes.Register(r)`, `routes.Register(r)
+	auth.Register(r, auth.NewMemoryStore(), []byte(os.Getenv("SESSION_KEY")))`)},
+	"fiber": {"main.go", patchSimple("fiber-starter", `routes.Register(app)`, `routes.Register(app)
+	auth.Register(app, auth.NewMemoryStore(), []byte(os.Getenv("SESSION_KEY")))`)},
+	"chi": {"main.go", patchSimple("chi-starter", `http.ListenAndServe(":3000", telemetry.Middleware(logger)(routes.Router()))`, `r := routes.Router()
+	auth.Register(r, auth.NewMemoryStore(), []byte(os.Getenv("SESSION_KEY")))
+	http.ListenAndServe(":3000", telemetry.Middleware(logger)(r))`)},
+	"gorilla": {"main.go", patchSimple("gorilla-starter", `r.HandleFunc("/", handlers.Home).Methods("GET")`, `r.HandleFunc("/", handlers.Home).Methods("GET")
+	auth.Register(r, auth.NewMemoryStore(), []byte(os.Getenv("SESSION_KEY")))`)},
+	"buffalo": {filepath.Join("actions", "home.go"), patchBuffalo},
+}
+
+// Apply copies the shared auth package, the framework-specific handlers, and
+// an in-memory Store into destDir, then wires them into the framework's
+// entry point.
+func Apply(templateName, templatesRoot, destDir string) error {
+	entry, ok := frameworkPatch[templateName]
+	if !ok {
+		return fmt.Errorf("--auth is not supported for template %q", templateName)
+	}
+
+	if err := copyDir(filepath.Join(templatesRoot, sharedAuthDir, "pkg", "auth"), filepath.Join(destDir, "pkg", "auth")); err != nil {
+		return err
+	}
+	if err := copyDir(filepath.Join(templatesRoot, sharedAuthDir, "models"), filepath.Join(destDir, "models")); err != nil {
+		return err
+	}
+	if err := copyDir(filepath.Join(templatesRoot, templateName, "auth"), filepath.Join(destDir, "auth")); err != nil {
+		return err
+	}
+
+	if err := writeSessionKey(destDir); err != nil {
+		return err
+	}
+
+	entryPath := filepath.Join(destDir, entry.entryFile)
+	data, err := os.ReadFile(entryPath)
+	if err != nil {
+		return err
+	}
+	patched, ok := entry.patch(string(data))
+	if !ok {
+		return fmt.Errorf("could not find expected router setup in %s", entryPath)
+	}
+	return os.WriteFile(entryPath, []byte(patched), 0644)
+}
+
+func patchSimple(module, marker, replacement string) func(string) (string, bool) {
+	return func(src string) (string, bool) {
+		if !strings.Contains(src, marker) {
+			return src, false
+		}
+		src = strings.Replace(src, marker, replacement, 1)
+		// Every starter's entry point imports <module>/telemetry (gorilla's
+		// has no <module>/routes), so anchor the auth import there.
+		if !strings.Contains(src, `"`+module+`/auth"`) {
+			src = strings.Replace(src, `"`+module+`/telemetry"`, `"`+module+`/auth"
+	"`+module+`/telemetry"`, 1)
+		}
+		if !strings.Contains(src, `"os"`) {
+			src = strings.Replace(src, "import (\n", "import (\n\t\"os\"\n\n", 1)
+		}
+		return src, true
+	}
+}
+
+// patchBuffalo wires auth.Register into actions/home.go's App(), which
+// (unlike the other starters) has no top-level main.go to patch.
+func patchBuffalo(src string) (string, bool) {
+	marker := `app.GET("/", func(c buffalo.Context) error {
+		return c.Render(200, r.String("Hello Buffalo"))
+	})`
+	if !strings.Contains(src, marker) {
+		return src, false
+	}
+	replacement := marker + `
+	auth.Register(app, auth.NewMemoryStore(), []byte(os.Getenv("SESSION_KEY")))`
+	src = strings.Replace(src, marker, replacement, 1)
+	if !strings.Contains(src, `"buffalo-starter/auth"`) {
+		src = strings.Replace(src, `"buffalo-starter/telemetry"`, `"buffalo-starter/auth"
+	"buffalo-starter/telemetry"`, 1)
+	}
+	return src, true
+}
+
+func writeSessionKey(destDir string) error {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	line := "SESSION_KEY=" + hex.EncodeToString(key) + "\n"
+
+	envPath := filepath.Join(destDir, ".env")
+	existing, err := os.ReadFile(envPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.WriteFile(envPath, append(existing, []byte(line)...), 0600)
+}
+
+func copyDir(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		srcPath := filepath.Join(src, e.Name())
+		dstPath := filepath.Join(dst, e.Name())
+		if e.IsDir() {
+			if err := copyDir(srcPath, dstPath); err != nil {
+				return err
+			}
+			continue
+		}
+		data, err := os.ReadFile(srcPath)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(dstPath, data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Package dbscaffold implements `pi generate model` and `pi migrate` /
+// `pi rollback`: it writes a model struct, a versioned SQL migration, and a
+// typed repository, then applies migrations against DATABASE_URL.
+package dbscaffold
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Driver selects the GORM dialector a generated project uses.
+type Driver string
+
+const (
+	Postgres Driver = "postgres"
+	MySQL    Driver = "mysql"
+	SQLite   Driver = "sqlite"
+)
+
+// Field is one struct field on a generated model, e.g. "Name string".
+type Field struct {
+	Name string
+	Type string
+}
+
+// ModelSpec describes a `pi generate model <Name> field:type ...` invocation.
+type ModelSpec struct {
+	Name   string
+	Fields []Field
+}
+
+// ParseFields turns "name:string" style args into Fields, capitalizing the
+// field name so it's exported on the generated struct.
+func ParseFields(args []string) ([]Field, error) {
+	fields := make([]Field, 0, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid field %q, expected name:type", arg)
+		}
+		fields = append(fields, Field{
+			Name: Capitalize(parts[0]),
+			Type: parts[1],
+		})
+	}
+	return fields, nil
+}
+
+// Capitalize upper-cases name's first byte so it's exported from the
+// models/repository packages it's written into.
+func Capitalize(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
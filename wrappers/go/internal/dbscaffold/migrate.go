@@ -0,0 +1,156 @@
+package dbscaffold
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const schemaMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT PRIMARY KEY)`
+
+// Migrate applies, in version order, every migration in migrationsDir whose
+// version is not yet recorded in schema_migrations. driver ("postgres", "mysql",
+// "sqlite") picks the placeholder syntax, matching internal/db.Open.
+func Migrate(conn *sql.DB, driver, migrationsDir string) error {
+	if _, err := conn.Exec(schemaMigrationsTable); err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+
+	applied, err := appliedVersions(conn)
+	if err != nil {
+		return err
+	}
+
+	files, err := migrationFiles(migrationsDir)
+	if err != nil {
+		return err
+	}
+
+	insert := fmt.Sprintf("INSERT INTO schema_migrations (version) VALUES (%s)", placeholder(driver, 1))
+	for _, f := range files {
+		if applied[f.version] {
+			continue
+		}
+		if err := runSection(conn, f.path, "+up"); err != nil {
+			return fmt.Errorf("applying %s: %w", f.path, err)
+		}
+		if _, err := conn.Exec(insert, f.version); err != nil {
+			return fmt.Errorf("recording %s: %w", f.path, err)
+		}
+	}
+	return nil
+}
+
+// Rollback reverts the single applied migration with the highest version. driver
+// ("postgres", "mysql", "sqlite") picks the placeholder syntax, matching
+// internal/db.Open.
+func Rollback(conn *sql.DB, driver, migrationsDir string) error {
+	applied, err := appliedVersions(conn)
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		return fmt.Errorf("no migrations applied")
+	}
+
+	latest := 0
+	for v := range applied {
+		if v > latest {
+			latest = v
+		}
+	}
+
+	files, err := migrationFiles(migrationsDir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.version != latest {
+			continue
+		}
+		if err := runSection(conn, f.path, "+down"); err != nil {
+			return fmt.Errorf("rolling back %s: %w", f.path, err)
+		}
+		del := fmt.Sprintf("DELETE FROM schema_migrations WHERE version = %s", placeholder(driver, 1))
+		_, err := conn.Exec(del, latest)
+		return err
+	}
+	return fmt.Errorf("migration file for version %d not found", latest)
+}
+
+// placeholder returns the nth positional bind placeholder for driver: "$n"
+// for Postgres, "?" for MySQL and SQLite.
+func placeholder(driver string, n int) string {
+	if driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+type migrationFile struct {
+	version int
+	path    string
+}
+
+func migrationFiles(dir string) ([]migrationFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]migrationFile, 0, len(entries))
+	for _, e := range entries {
+		parts := strings.SplitN(e.Name(), "_", 2)
+		v, err := strconv.Atoi(parts[0])
+		if err != nil {
+			continue
+		}
+		files = append(files, migrationFile{version: v, path: filepath.Join(dir, e.Name())})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].version < files[j].version })
+	return files, nil
+}
+
+func appliedVersions(conn *sql.DB) (map[int]bool, error) {
+	rows, err := conn.Query("SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int]bool{}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		applied[v] = true
+	}
+	return applied, rows.Err()
+}
+
+// runSection executes the statements under "-- +up" or "-- +down" in a
+// migration file.
+func runSection(conn *sql.DB, path, section string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	marker := "-- " + section
+	idx := strings.Index(string(data), marker)
+	if idx == -1 {
+		return fmt.Errorf("section %q not found", section)
+	}
+	rest := string(data[idx+len(marker):])
+	if next := strings.Index(rest, "-- +"); next != -1 {
+		rest = rest[:next]
+	}
+
+	_, err = conn.Exec(rest)
+	return err
+}
@@ -0,0 +1,83 @@
+package dbscaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GenerateMigration writes <migrationsDir>/NNNN_create_<table>.sql, where
+// <table> is spec.Name lower-cased plus "s" (e.g. 0001_create_users.sql for
+// User), containing an "-- +up" / "-- +down" pair for spec, and returns the
+// file it wrote.
+func GenerateMigration(spec ModelSpec, migrationsDir string) (string, error) {
+	version, err := nextVersion(migrationsDir)
+	if err != nil {
+		return "", err
+	}
+
+	table := strings.ToLower(spec.Name) + "s"
+	var cols strings.Builder
+	cols.WriteString("id SERIAL PRIMARY KEY")
+	for _, f := range spec.Fields {
+		cols.WriteString(fmt.Sprintf(",\n    %s %s", strings.ToLower(f.Name), sqlType(f.Type)))
+	}
+
+	contents := fmt.Sprintf(`-- +up
+CREATE TABLE %s (
+    %s
+);
+
+-- +down
+DROP TABLE %s;
+`, table, cols.String(), table)
+
+	if err := os.MkdirAll(migrationsDir, 0755); err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%04d_create_%s.sql", version, table)
+	path := filepath.Join(migrationsDir, name)
+	return path, os.WriteFile(path, []byte(contents), 0644)
+}
+
+func nextVersion(migrationsDir string) (int, error) {
+	entries, err := os.ReadDir(migrationsDir)
+	if os.IsNotExist(err) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	versions := make([]int, 0, len(entries))
+	for _, e := range entries {
+		parts := strings.SplitN(e.Name(), "_", 2)
+		if n, err := strconv.Atoi(parts[0]); err == nil {
+			versions = append(versions, n)
+		}
+	}
+	sort.Ints(versions)
+	if len(versions) == 0 {
+		return 1, nil
+	}
+	return versions[len(versions)-1] + 1, nil
+}
+
+func sqlType(goType string) string {
+	switch goType {
+	case "string":
+		return "TEXT"
+	case "int", "uint", "int64", "uint64":
+		return "BIGINT"
+	case "float32", "float64":
+		return "DOUBLE PRECISION"
+	case "bool":
+		return "BOOLEAN"
+	default:
+		return "TEXT"
+	}
+}
@@ -0,0 +1,52 @@
+package dbscaffold
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+var modelTmpl = template.Must(template.New("model").Parse(`package models
+
+type {{.Name}} struct {
+	ID uint ` + "`gorm:\"primaryKey\"`" + `
+{{range .Fields}}	{{.Name}} {{.Type}}
+{{end}}}
+`))
+
+// GenerateModel writes <modelsDir>/<name_lower>.go with the struct for spec,
+// then adds it to the front of the central AutoMigrate list in models.go.
+func GenerateModel(spec ModelSpec, modelsDir string) error {
+	var buf bytes.Buffer
+	if err := modelTmpl.Execute(&buf, spec); err != nil {
+		return err
+	}
+
+	fileName := strings.ToLower(spec.Name) + ".go"
+	if err := os.WriteFile(filepath.Join(modelsDir, fileName), buf.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	return registerInAutoMigrate(filepath.Join(modelsDir, "models.go"), spec.Name)
+}
+
+// registerInAutoMigrate adds &<name>{} to the slice literal returned by
+// All() so main.go's AutoMigrate call picks up the new model.
+func registerInAutoMigrate(modelsFile, name string) error {
+	data, err := os.ReadFile(modelsFile)
+	if err != nil {
+		return err
+	}
+
+	needle := "return []interface{}{"
+	idx := strings.Index(string(data), needle)
+	if idx == -1 {
+		return fmt.Errorf("%s: could not find AutoMigrate slice literal", modelsFile)
+	}
+	insertAt := idx + len(needle)
+	updated := string(data[:insertAt]) + fmt.Sprintf("&%s{}, ", name) + string(data[insertAt:])
+	return os.WriteFile(modelsFile, []byte(updated), 0644)
+}
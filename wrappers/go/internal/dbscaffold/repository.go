@@ -0,0 +1,75 @@
+package dbscaffold
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+var repositoryTmpl = template.Must(template.New("repository").Parse(`package repository
+
+import (
+	"gorm.io/gorm"
+
+	"gorm-starter/models"
+)
+
+type {{.Name}}Repository interface {
+	Create(m *models.{{.Name}}) error
+	Get(id uint) (*models.{{.Name}}, error)
+	List() ([]models.{{.Name}}, error)
+	Update(m *models.{{.Name}}) error
+	Delete(id uint) error
+}
+
+type gorm{{.Name}}Repository struct {
+	db *gorm.DB
+}
+
+func New{{.Name}}Repository(db *gorm.DB) {{.Name}}Repository {
+	return &gorm{{.Name}}Repository{db: db}
+}
+
+func (r *gorm{{.Name}}Repository) Create(m *models.{{.Name}}) error {
+	return r.db.Create(m).Error
+}
+
+func (r *gorm{{.Name}}Repository) Get(id uint) (*models.{{.Name}}, error) {
+	var m models.{{.Name}}
+	if err := r.db.First(&m, id).Error; err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *gorm{{.Name}}Repository) List() ([]models.{{.Name}}, error) {
+	var ms []models.{{.Name}}
+	err := r.db.Find(&ms).Error
+	return ms, err
+}
+
+func (r *gorm{{.Name}}Repository) Update(m *models.{{.Name}}) error {
+	return r.db.Save(m).Error
+}
+
+func (r *gorm{{.Name}}Repository) Delete(id uint) error {
+	return r.db.Delete(&models.{{.Name}}{}, id).Error
+}
+`))
+
+// GenerateRepository writes <repoDir>/<name_lower>_repository.go with a
+// typed CRUD repository for spec backed by GORM.
+func GenerateRepository(spec ModelSpec, repoDir string) error {
+	var buf bytes.Buffer
+	if err := repositoryTmpl.Execute(&buf, spec); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(repoDir, 0755); err != nil {
+		return err
+	}
+	fileName := strings.ToLower(spec.Name) + "_repository.go"
+	return os.WriteFile(filepath.Join(repoDir, fileName), buf.Bytes(), 0644)
+}
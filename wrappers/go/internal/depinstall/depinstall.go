@@ -0,0 +1,33 @@
+// Package depinstall fetches and upgrades a scaffolded Go project's
+// dependencies by running the go toolchain directly against dir, replacing
+// the legacy Node bundle's install/update flow for `pi install`/`pi update`.
+package depinstall
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Install runs `go mod tidy` in dir, fetching every dependency the
+// scaffolded go.mod references.
+func Install(dir string) error {
+	return run(dir, "go", "mod", "tidy")
+}
+
+// Update upgrades every dependency in dir to its latest minor/patch
+// release, then tidies go.mod/go.sum.
+func Update(dir string) error {
+	if err := run(dir, "go", "get", "-u", "./..."); err != nil {
+		return err
+	}
+	return run(dir, "go", "mod", "tidy")
+}
+
+func run(dir, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	return cmd.Run()
+}
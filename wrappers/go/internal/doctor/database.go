@@ -0,0 +1,33 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// databaseCheck verifies the CLI for one of the GORM starters' drivers is
+// installed (psql, mysql, sqlite3).
+type databaseCheck struct {
+	driver string
+	binary string
+}
+
+func (c databaseCheck) Name() string { return "db-" + c.driver }
+
+func (c databaseCheck) Run(ctx context.Context) Result {
+	if _, err := exec.LookPath(c.binary); err != nil {
+		return Result{
+			Status:      Warn,
+			Detail:      fmt.Sprintf("%s is not on PATH", c.binary),
+			Remediation: fmt.Sprintf("install the %s client to use `pi migrate` against a %s DATABASE_URL", c.binary, c.driver),
+		}
+	}
+	return Result{Status: Pass, Detail: c.binary + " found"}
+}
+
+func init() {
+	Register(databaseCheck{driver: "postgres", binary: "psql"})
+	Register(databaseCheck{driver: "mysql", binary: "mysql"})
+	Register(databaseCheck{driver: "sqlite", binary: "sqlite3"})
+}
@@ -0,0 +1,19 @@
+package doctor
+
+import (
+	"context"
+	"os/exec"
+)
+
+type dockerCheck struct{}
+
+func (dockerCheck) Name() string { return "docker" }
+
+func (dockerCheck) Run(ctx context.Context) Result {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return Result{Status: Warn, Detail: "docker is not on PATH", Remediation: "install Docker; required for vanity-service Dockerfile builds"}
+	}
+	return Result{Status: Pass, Detail: "docker found"}
+}
+
+func init() { Register(dockerCheck{}) }
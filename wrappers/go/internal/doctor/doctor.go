@@ -0,0 +1,57 @@
+// Package doctor replaces the old one-off isNodeAvailable/
+// areDependenciesInstalled helpers with a pluggable set of environment
+// checks that `pi doctor` runs and reports on.
+package doctor
+
+import "context"
+
+// Status is the outcome of running a Check.
+type Status int
+
+const (
+	Pass Status = iota
+	Warn
+	Fail
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pass:
+		return "pass"
+	case Warn:
+		return "warn"
+	default:
+		return "fail"
+	}
+}
+
+// Result is what a Check reports back.
+type Result struct {
+	Status      Status
+	Detail      string // human-readable explanation
+	Remediation string // hint shown when Status != Pass
+}
+
+// Check is one thing `pi doctor` can verify, and optionally fix.
+type Check interface {
+	Name() string
+	Run(ctx context.Context) Result
+}
+
+// Fixable is implemented by checks that support `pi doctor --fix`.
+type Fixable interface {
+	Fix(ctx context.Context) error
+}
+
+var registry []Check
+
+// Register adds c to the set `pi doctor` runs. Called from init() in each
+// check's file.
+func Register(c Check) {
+	registry = append(registry, c)
+}
+
+// All returns every registered check.
+func All() []Check {
+	return registry
+}
@@ -0,0 +1,19 @@
+package doctor
+
+import (
+	"context"
+	"os/exec"
+)
+
+type gitCheck struct{}
+
+func (gitCheck) Name() string { return "git" }
+
+func (gitCheck) Run(ctx context.Context) Result {
+	if _, err := exec.LookPath("git"); err != nil {
+		return Result{Status: Fail, Detail: "git is not on PATH", Remediation: "install git"}
+	}
+	return Result{Status: Pass, Detail: "git found"}
+}
+
+func init() { Register(gitCheck{}) }
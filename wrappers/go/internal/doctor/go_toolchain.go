@@ -0,0 +1,25 @@
+package doctor
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+type goToolchainCheck struct{}
+
+func (goToolchainCheck) Name() string { return "go-toolchain" }
+
+func (goToolchainCheck) Run(ctx context.Context) Result {
+	out, err := exec.CommandContext(ctx, "go", "version").Output()
+	if err != nil {
+		return Result{Status: Fail, Detail: "go is not on PATH", Remediation: "install Go from https://go.dev/dl"}
+	}
+	if os.Getenv("GOPATH") == "" {
+		return Result{Status: Warn, Detail: strings.TrimSpace(string(out)), Remediation: "GOPATH is unset; `go env -w GOPATH=$(go env GOPATH)` to pin it"}
+	}
+	return Result{Status: Pass, Detail: strings.TrimSpace(string(out))}
+}
+
+func init() { Register(goToolchainCheck{}) }
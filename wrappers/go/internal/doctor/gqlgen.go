@@ -0,0 +1,29 @@
+package doctor
+
+import (
+	"context"
+	"os/exec"
+)
+
+// gqlgenCheck verifies the gqlgen CLI is installed, since the gqlgen
+// starter's schema is produced by `go generate` calling out to it.
+type gqlgenCheck struct{}
+
+func (gqlgenCheck) Name() string { return "gqlgen-cli" }
+
+func (gqlgenCheck) Run(ctx context.Context) Result {
+	if _, err := exec.LookPath("gqlgen"); err != nil {
+		return Result{
+			Status:      Warn,
+			Detail:      "gqlgen is not on PATH",
+			Remediation: "run `pi doctor --fix`, or `go install github.com/99designs/gqlgen@latest`",
+		}
+	}
+	return Result{Status: Pass, Detail: "gqlgen found"}
+}
+
+func (gqlgenCheck) Fix(ctx context.Context) error {
+	return exec.CommandContext(ctx, "go", "install", "github.com/99designs/gqlgen@latest").Run()
+}
+
+func init() { Register(gqlgenCheck{}) }
@@ -0,0 +1,43 @@
+package doctor
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+const manifestName = "doctor.yaml"
+
+type manifest struct {
+	Checks []string `yaml:"checks"`
+}
+
+// Selected returns the checks a project's doctor.yaml requires, in the
+// directory dir. Absent a manifest, every registered check runs.
+func Selected(dir string) ([]Check, error) {
+	data, err := os.ReadFile(dir + string(os.PathSeparator) + manifestName)
+	if os.IsNotExist(err) {
+		return All(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var m manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(m.Checks))
+	for _, name := range m.Checks {
+		wanted[name] = true
+	}
+
+	selected := make([]Check, 0, len(wanted))
+	for _, c := range All() {
+		if wanted[c.Name()] {
+			selected = append(selected, c)
+		}
+	}
+	return selected, nil
+}
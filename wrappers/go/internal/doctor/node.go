@@ -0,0 +1,21 @@
+package doctor
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+)
+
+type nodeCheck struct{}
+
+func (nodeCheck) Name() string { return "node" }
+
+func (nodeCheck) Run(ctx context.Context) Result {
+	out, err := exec.CommandContext(ctx, "node", "--version").Output()
+	if err != nil {
+		return Result{Status: Warn, Detail: "node is not on PATH", Remediation: "install Node.js; required for --legacy and unported subcommands"}
+	}
+	return Result{Status: Pass, Detail: strings.TrimSpace(string(out))}
+}
+
+func init() { Register(nodeCheck{}) }
@@ -0,0 +1,77 @@
+// Package installer copies a resolved template into a destination directory
+// and writes a go.mod for it so the scaffolded project builds standalone.
+package installer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"package-installer-cli/internal/templates"
+)
+
+// goModVersion is the Go version declared in every generated go.mod. It
+// matches the golang:1.22-alpine base image used by the vanity-service
+// Dockerfile template.
+const goModVersion = "1.22"
+
+// New scaffolds template t into destDir, which must not already exist. The
+// generated tree gets a go.mod declaring module t.Module, which is the
+// module path every template's own source already imports (e.g.
+// "gin-starter/routes"), so the scaffolded project builds without the
+// caller having to rewrite import paths.
+func New(t templates.Template, destDir string) error {
+	if _, err := os.Stat(destDir); err == nil {
+		return fmt.Errorf("destination %q already exists", destDir)
+	}
+
+	srcDir := filepath.Join(templates.Root, t.SourceDir)
+	if err := filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		out := filepath.Join(destDir, rel)
+		if info.IsDir() {
+			return os.MkdirAll(out, 0755)
+		}
+		return copyFile(path, out, info.Mode())
+	}); err != nil {
+		return err
+	}
+
+	return writeGoMod(t, destDir)
+}
+
+func writeGoMod(t templates.Template, destDir string) error {
+	goModPath := filepath.Join(destDir, "go.mod")
+	if _, err := os.Stat(goModPath); err == nil {
+		return nil
+	}
+	contents := fmt.Sprintf("module %s\n\ngo %s\n", t.Module, goModVersion)
+	return os.WriteFile(goModPath, []byte(contents), 0644)
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
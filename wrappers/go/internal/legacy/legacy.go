@@ -0,0 +1,89 @@
+// Package legacy runs the embedded Node bundle for subcommands that have not
+// been ported to native Go yet, or when the user passes --legacy.
+package legacy
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+//go:embed bundle-executables/**
+var embeddedBundle embed.FS
+
+// Run extracts the embedded Node bundle to a temp directory and execs the
+// platform-appropriate pi script, forwarding args and exit code.
+func Run(args []string) error {
+	tempDir, err := os.MkdirTemp("", "pi-bundle-*")
+	if err != nil {
+		return fmt.Errorf("creating temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	if err := extractBundle(tempDir); err != nil {
+		return fmt.Errorf("extracting embedded bundle: %w", err)
+	}
+
+	scriptPath := scriptForPlatform(tempDir)
+	if runtime.GOOS != "windows" {
+		os.Chmod(scriptPath, 0755)
+	}
+
+	cmd := exec.Command(scriptPath, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			os.Exit(exitErr.ExitCode())
+		}
+		return fmt.Errorf("executing pi script: %w", err)
+	}
+	return nil
+}
+
+func extractBundle(tempDir string) error {
+	return fs.WalkDir(embeddedBundle, "bundle-executables", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath := strings.TrimPrefix(path, "bundle-executables/")
+		if relPath == "" {
+			return nil
+		}
+		outPath := filepath.Join(tempDir, relPath)
+		if d.IsDir() {
+			return os.MkdirAll(outPath, 0755)
+		}
+		data, err := embeddedBundle.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(outPath, data, 0755)
+	})
+}
+
+func scriptForPlatform(tempDir string) string {
+	switch runtime.GOOS {
+	case "windows":
+		path := filepath.Join(tempDir, "pi.bat")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return filepath.Join(tempDir, "pi.exe")
+		}
+		return path
+	case "darwin":
+		path := filepath.Join(tempDir, "pi-macos")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return filepath.Join(tempDir, "pi")
+		}
+		return path
+	default:
+		return filepath.Join(tempDir, "pi")
+	}
+}
@@ -0,0 +1,15 @@
+// Package registry tracks which subcommands have a native Go implementation
+// so the root command knows when to fall back to the legacy Node bundle.
+package registry
+
+var ported = map[string]bool{}
+
+// MarkPorted records that subcommand name has a native implementation.
+func MarkPorted(name string) {
+	ported[name] = true
+}
+
+// IsPorted reports whether subcommand name runs natively.
+func IsPorted(name string) bool {
+	return ported[name]
+}
@@ -0,0 +1,77 @@
+// Package telemetry is pi's own structured logging and opt-in usage pings,
+// as distinct from the telemetry package scaffolded into generated projects.
+package telemetry
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/BurntSushi/toml"
+)
+
+// NewLogger returns pi's process-wide structured logger.
+func NewLogger() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(os.Stderr, nil))
+}
+
+type config struct {
+	Enabled bool `toml:"enabled"`
+}
+
+func configPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "pi", "config.toml"), nil
+}
+
+// SetEnabled persists the user's `pi telemetry on|off` choice.
+func SetEnabled(enabled bool) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return toml.NewEncoder(f).Encode(config{Enabled: enabled})
+}
+
+// Enabled reports whether usage pings are turned on. Disabled by default.
+func Enabled() bool {
+	path, err := configPath()
+	if err != nil {
+		return false
+	}
+	var cfg config
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		return false
+	}
+	return cfg.Enabled
+}
+
+// PingTemplateChosen logs an anonymous usage event for template, when
+// enabled. No destination is wired up yet; this is the local record a
+// future exporter will ship.
+func PingTemplateChosen(logger *slog.Logger, template string) {
+	if !Enabled() {
+		return
+	}
+	event, _ := json.Marshal(map[string]string{
+		"template": template,
+		"go":       runtime.Version(),
+		"os":       runtime.GOOS,
+		"arch":     runtime.GOARCH,
+	})
+	logger.Info("usage ping", "event", string(event))
+}
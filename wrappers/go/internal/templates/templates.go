@@ -0,0 +1,44 @@
+// Package templates resolves the Go starter templates bundled under
+// core-cli/templates/go so native subcommands can scaffold projects without
+// shelling out to Node.
+package templates
+
+import "fmt"
+
+// Root is where every template's SourceDir is rooted.
+const Root = "core-cli/templates/go"
+
+// Template describes one scaffoldable Go starter.
+type Template struct {
+	Name      string // e.g. "gin"
+	Module    string // default go.mod module name, e.g. "gin-starter"
+	SourceDir string // path relative to Root
+}
+
+var known = []Template{
+	{Name: "gin", Module: "gin-starter", SourceDir: "gin/template"},
+	{Name: "fiber", Module: "fiber-starter", SourceDir: "fiber/template"},
+	{Name: "chi", Module: "chi-starter", SourceDir: "chi/template"},
+	{Name: "gorilla", Module: "gorilla-starter", SourceDir: "gorilla/template"},
+	{Name: "buffalo", Module: "buffalo-starter", SourceDir: "buffalo/template"},
+	{Name: "gqlgen", Module: "gqlgen-starter", SourceDir: "gqlgen/template"},
+	{Name: "vanity-service", Module: "vanity-service", SourceDir: "vanity-service/template"},
+	// gorm lives under core-cli/features/database, not core-cli/templates/go,
+	// so its SourceDir climbs back out of Root to reach it.
+	{Name: "gorm", Module: "gorm-starter", SourceDir: "../../features/database/gorm/template"},
+}
+
+// Find looks up a known template by name.
+func Find(name string) (Template, error) {
+	for _, t := range known {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return Template{}, fmt.Errorf("unknown template %q", name)
+}
+
+// List returns every known template.
+func List() []Template {
+	return known
+}
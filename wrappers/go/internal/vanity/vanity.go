@@ -0,0 +1,57 @@
+// Package vanity scaffolds a self-hosted go-get vanity redirector project
+// from the vanity-service template.
+package vanity
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"package-installer-cli/internal/installer"
+	"package-installer-cli/internal/templates"
+)
+
+// Config describes the redirector being generated.
+type Config struct {
+	Domain       string // e.g. "example.com"
+	VCS          string // "git", matches the go-import meta tag's vcs field
+	PackagesFile string // path to an existing TOML package map, or "" to use the template default; the file is copied in as packages.toml unconverted, so a YAML map is not supported
+}
+
+// domainLine and vcsLine match packages.toml's top-level "domain = ..." and
+// "vcs = ..." keys so Generate can rewrite them in place.
+var (
+	domainLine = regexp.MustCompile(`(?m)^domain\s*=\s*".*"$`)
+	vcsLine    = regexp.MustCompile(`(?m)^vcs\s*=\s*".*"$`)
+)
+
+// Generate scaffolds destDir with a runnable vanity redirector server,
+// rewriting packages.toml's domain/vcs keys to match cfg.
+func Generate(cfg Config, destDir string) error {
+	t, err := templates.Find("vanity-service")
+	if err != nil {
+		return err
+	}
+	if err := installer.New(t, destDir); err != nil {
+		return err
+	}
+
+	packagesPath := filepath.Join(destDir, "packages.toml")
+	data, err := os.ReadFile(packagesPath)
+	if err != nil {
+		return err
+	}
+
+	if cfg.PackagesFile != "" {
+		data, err = os.ReadFile(cfg.PackagesFile)
+		if err != nil {
+			return fmt.Errorf("reading package list: %w", err)
+		}
+	}
+
+	data = domainLine.ReplaceAll(data, []byte(fmt.Sprintf("domain = %q", cfg.Domain)))
+	data = vcsLine.ReplaceAll(data, []byte(fmt.Sprintf("vcs = %q", cfg.VCS)))
+
+	return os.WriteFile(packagesPath, data, 0644)
+}